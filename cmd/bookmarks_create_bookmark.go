@@ -16,8 +16,13 @@ import (
 
 // createBookmarkCmd represents the get-bookmark command
 var createBookmarkCmd = &cobra.Command{
-	Use:    "create-bookmark [--file FILE]",
-	Short:  "Creates a bookmark from JSON.",
+	Use:   "create-bookmark [--file FILE]",
+	Short: "Creates a bookmark from JSON.",
+	Long: `Creates a bookmark from JSON.
+
+The type, scope and query of each query in the bookmark can contain template
+parameters in the form ${name}. These are stored as-is and resolved every time
+the bookmark is run using 'request load --bookmark-uuid ID --param name=value'.`,
 	PreRun: PreRunSetup,
 	RunE:   CreateBookmark,
 }
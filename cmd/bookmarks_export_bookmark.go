@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/overmindtech/sdp-go"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// exportBookmarkCmd represents the export-bookmark command
+var exportBookmarkCmd = &cobra.Command{
+	Use:   "export-bookmark --uuid ID [--file FILE]",
+	Short: "Exports a bookmark to a portable JSON file.",
+	Long: `Exports a bookmark to a portable JSON file. The file can be imported into
+another workspace using create-bookmark.`,
+	PreRun: PreRunSetup,
+	RunE:   ExportBookmark,
+}
+
+func ExportBookmark(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	bookmarkUuid, err := uuid.Parse(viper.GetString("uuid"))
+	if err != nil {
+		return flagError{
+			usage: fmt.Sprintf("invalid --uuid value '%v' (%v)\n\n%v", viper.GetString("uuid"), err, cmd.UsageString()),
+		}
+	}
+
+	ctx, oi, _, err := login(ctx, cmd, []string{"changes:read"}, nil)
+	if err != nil {
+		return err
+	}
+
+	client := AuthenticatedBookmarkClient(ctx, oi)
+	response, err := client.GetBookmark(ctx, &connect.Request[sdp.GetBookmarkRequest]{
+		Msg: &sdp.GetBookmarkRequest{
+			UUID: bookmarkUuid[:],
+		},
+	})
+	if err != nil {
+		return loggedError{
+			err:     err,
+			message: "failed to get bookmark",
+		}
+	}
+
+	b, err := exportBookmarkJSON(response.Msg.GetBookmark())
+	if err != nil {
+		return loggedError{
+			err:     err,
+			message: "failed to export bookmark",
+		}
+	}
+
+	out := os.Stdout
+	if viper.GetString("file") != "" {
+		out, err = os.Create(viper.GetString("file"))
+		if err != nil {
+			return loggedError{
+				err:     err,
+				fields:  log.Fields{"file": viper.GetString("file")},
+				message: "failed to open output",
+			}
+		}
+		defer out.Close()
+	}
+
+	_, err = out.Write(b)
+	if err != nil {
+		return loggedError{
+			err:     err,
+			fields:  log.Fields{"file": viper.GetString("file")},
+			message: "failed to write bookmark",
+		}
+	}
+
+	log.WithContext(ctx).WithFields(log.Fields{
+		"bookmark-uuid": bookmarkUuid,
+		"bookmark-name": response.Msg.GetBookmark().GetProperties().GetName(),
+	}).Info("exported bookmark")
+
+	return nil
+}
+
+// exportBookmarkJSON renders the bookmark's properties in the JSON format that
+// is read by create-bookmark. The metadata is left out, as it is assigned
+// anew when the bookmark is created.
+func exportBookmarkJSON(b *sdp.Bookmark) ([]byte, error) {
+	out, err := json.MarshalIndent(b.GetProperties(), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal bookmark: %w", err)
+	}
+	return append(out, '\n'), nil
+}
+
+func init() {
+	bookmarksCmd.AddCommand(exportBookmarkCmd)
+
+	exportBookmarkCmd.PersistentFlags().String("uuid", "", "The UUID of the bookmark that should be exported.")
+	exportBookmarkCmd.PersistentFlags().String("file", "", "File to write the exported bookmark to. (defaults to stdout)")
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/overmindtech/sdp-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBookmarkExportImport(t *testing.T) {
+	u := uuid.New()
+	original := &sdp.Bookmark{
+		Metadata: &sdp.BookmarkMetadata{
+			UUID:    u[:],
+			Created: timestamppb.Now(),
+		},
+		Properties: &sdp.BookmarkProperties{
+			Name:        "production databases",
+			Description: "all databases and their dependencies",
+			Queries: []*sdp.Query{
+				{
+					Type:   "rds-db-instance",
+					Method: sdp.QueryMethod_LIST,
+					Scope:  "123456789012.eu-west-2",
+					RecursionBehaviour: &sdp.Query_RecursionBehaviour{
+						LinkDepth:                  3,
+						FollowOnlyBlastPropagation: true,
+					},
+				},
+				{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Scope:  "global",
+					Query:  "db.example.com",
+				},
+			},
+			ExcludedItems: []*sdp.Reference{
+				{
+					Type:                 "rds-db-instance",
+					UniqueAttributeValue: "scratch",
+					Scope:                "123456789012.eu-west-2",
+				},
+			},
+		},
+	}
+
+	b, err := exportBookmarkJSON(original)
+	if err != nil {
+		t.Fatalf("unexpected error exporting: %v", err)
+	}
+
+	// parse the export the same way create-bookmark does
+	imported := &sdp.BookmarkProperties{}
+	err = json.Unmarshal(b, imported)
+	if err != nil {
+		t.Fatalf("unexpected error importing: %v", err)
+	}
+
+	if !proto.Equal(original.GetProperties(), imported) {
+		t.Errorf("expected properties to round-trip\noriginal: %v\nimported: %v", original.GetProperties(), imported)
+	}
+}
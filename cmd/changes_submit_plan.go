@@ -143,7 +143,7 @@ func SubmitPlan(cmd *cobra.Command, args []string) error {
 			log.WithContext(ctx).WithError(err).WithFields(lf).Debug("Failed to detect repository URL. Use the --repo flag to specify it manually if you require it")
 		}
 	}
-	tags, err := parseTagsArgument()
+	tags, err := parseKeyValueFlag("tags")
 	if err != nil {
 		return loggedError{
 			err:     err,
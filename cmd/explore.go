@@ -21,6 +21,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/sourcegraph/conc/pool"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"golang.org/x/oauth2"
 )
 
@@ -61,35 +62,40 @@ func StartLocalSources(ctx context.Context, oi sdp.OvermindInstance, token *oaut
 
 	p := pool.NewWithResults[*discovery.Engine]().WithErrors()
 
-	p.Go(func() (*discovery.Engine, error) {
-		ec := discovery.EngineConfig{
-			Version:               fmt.Sprintf("cli-%v", tracing.ServiceVersion),
-			EngineType:            "cli-stdlib",
-			SourceName:            fmt.Sprintf("stdlib-source-%v", hostname),
-			SourceUUID:            uuid.New(),
-			App:                   oi.ApiUrl.Host,
-			ApiKey:                token.AccessToken,
-			NATSOptions:           &natsOptions,
-			MaxParallelExecutions: 2_000,
-			HeartbeatOptions:      heartbeatOptions,
-		}
-		stdlibEngine, err := stdlibSource.InitializeEngine(
-			&ec,
-			true,
-		)
-		if err != nil {
-			stdlibSpinner.Fail("Failed to initialize stdlib source engine")
-			return nil, fmt.Errorf("failed to initialize stdlib source engine: %w", err)
-		}
-		// todo: pass in context with timeout to abort timely and allow Ctrl-C to work
-		err = stdlibEngine.Start()
-		if err != nil {
-			stdlibSpinner.Fail("Failed to start stdlib source engine")
-			return nil, fmt.Errorf("failed to start stdlib source engine: %w", err)
-		}
-		stdlibSpinner.Success("Stdlib source engine started")
-		return stdlibEngine, nil
-	})
+	if viper.GetBool("offline") {
+		// the stdlib source performs lookups against public internet services
+		stdlibSpinner.Warning("Stdlib source engine disabled in offline mode")
+	} else {
+		p.Go(func() (*discovery.Engine, error) {
+			ec := discovery.EngineConfig{
+				Version:               fmt.Sprintf("cli-%v", tracing.ServiceVersion),
+				EngineType:            "cli-stdlib",
+				SourceName:            fmt.Sprintf("stdlib-source-%v", hostname),
+				SourceUUID:            uuid.New(),
+				App:                   oi.ApiUrl.Host,
+				ApiKey:                token.AccessToken,
+				NATSOptions:           &natsOptions,
+				MaxParallelExecutions: 2_000,
+				HeartbeatOptions:      heartbeatOptions,
+			}
+			stdlibEngine, err := stdlibSource.InitializeEngine(
+				&ec,
+				true,
+			)
+			if err != nil {
+				stdlibSpinner.Fail("Failed to initialize stdlib source engine")
+				return nil, fmt.Errorf("failed to initialize stdlib source engine: %w", err)
+			}
+			// todo: pass in context with timeout to abort timely and allow Ctrl-C to work
+			err = stdlibEngine.Start()
+			if err != nil {
+				stdlibSpinner.Fail("Failed to start stdlib source engine")
+				return nil, fmt.Errorf("failed to start stdlib source engine: %w", err)
+			}
+			stdlibSpinner.Success("Stdlib source engine started")
+			return stdlibEngine, nil
+		})
+	}
 
 	p.Go(func() (*discovery.Engine, error) {
 		tfEval, err := tfutils.LoadEvalContext(tfArgs, os.Environ())
@@ -230,4 +236,5 @@ func init() {
 	rootCmd.AddCommand(exploreCmd)
 
 	addAPIFlags(exploreCmd)
+	addOfflineFlag(exploreCmd)
 }
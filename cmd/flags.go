@@ -31,16 +31,18 @@ func addChangeCreationFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringSlice("tags", []string{}, "Tags to apply to this change, these should be specified in key=value format. Multiple tags can be specified by repeating the flag or using a comma separated list.")
 }
 
-func parseTagsArgument() (map[string]string, error) {
-	tags := map[string]string{}
-	for _, tag := range viper.GetStringSlice("tags") {
-		parts := strings.SplitN(tag, "=", 2)
+// Parses a string slice flag whose values are in key=value format, like
+// `--tags`, into a map
+func parseKeyValueFlag(name string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, kv := range viper.GetStringSlice(name) {
+		parts := strings.SplitN(kv, "=", 2)
 		if len(parts) != 2 {
-			return tags, fmt.Errorf("invalid tag format: %s", tag)
+			return result, fmt.Errorf("invalid --%v format, expected key=value: %s", name, kv)
 		}
-		tags[parts[0]] = parts[1]
+		result[parts[0]] = parts[1]
 	}
-	return tags, nil
+	return result, nil
 }
 
 // Adds common flags to API commands e.g. timeout
@@ -58,4 +60,10 @@ func addTerraformBaseFlags(cmd *cobra.Command) {
 	cobra.CheckErr(cmd.PersistentFlags().MarkHidden("aws-config"))
 	cobra.CheckErr(cmd.PersistentFlags().MarkHidden("aws-profile"))
 	cmd.PersistentFlags().Bool("only-use-managed-sources", false, "Set this to skip local autoconfiguration and only use the managed sources as configured in Overmind.")
+	addOfflineFlag(cmd)
+}
+
+// Adds the flag to skip starting the local stdlib source
+func addOfflineFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool("offline", false, "Set this to skip starting the local stdlib source, which performs DNS, HTTP, RDAP and certificate lookups against public internet services. The AWS source and the connection to Overmind are not affected.")
 }
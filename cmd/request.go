@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/overmindtech/sdp-go"
@@ -33,7 +34,18 @@ var requestCmd = &cobra.Command{
 type requestHandler struct {
 	lf log.Fields
 
-	queriesStarted int
+	// mu protects the counters and results below, which are read by the
+	// progress view while the handler is still receiving messages
+	mu sync.Mutex
+
+	queriesStarted   int
+	queriesFinished  int
+	queriesErrored   int
+	queriesCancelled int
+
+	// liveProgress is set when the progress view is shown, new items and
+	// edges are then only logged at debug level to not interrupt the view
+	liveProgress bool
 
 	snapshotLoadResult chan *sdp.SnapshotLoadResult
 	bookmarkLoadResult chan *sdp.BookmarkLoadResult
@@ -50,23 +62,35 @@ var _ sdpws.GatewayMessageHandler = (*requestHandler)(nil)
 
 func (l *requestHandler) NewItem(ctx context.Context, item *sdp.Item) {
 	l.LoggingGatewayMessageHandler.NewItem(ctx, item)
+	l.mu.Lock()
 	l.items = append(l.items, item)
 	l.msgLog = append(l.msgLog, &sdp.GatewayResponse{
 		ResponseType: &sdp.GatewayResponse_NewItem{NewItem: item},
 	})
-	log.WithContext(ctx).WithFields(l.lf).WithField("item", item.GloballyUniqueName()).Infof("new item")
+	l.mu.Unlock()
+	log.WithContext(ctx).WithFields(l.lf).WithField("item", item.GloballyUniqueName()).Log(l.resultLogLevel(), "new item")
 }
 
 func (l *requestHandler) NewEdge(ctx context.Context, edge *sdp.Edge) {
 	l.LoggingGatewayMessageHandler.NewEdge(ctx, edge)
+	l.mu.Lock()
 	l.edges = append(l.edges, edge)
 	l.msgLog = append(l.msgLog, &sdp.GatewayResponse{
 		ResponseType: &sdp.GatewayResponse_NewEdge{NewEdge: edge},
 	})
+	l.mu.Unlock()
 	log.WithContext(ctx).WithFields(l.lf).WithFields(log.Fields{
 		"from": edge.GetFrom().GloballyUniqueName(),
 		"to":   edge.GetTo().GloballyUniqueName(),
-	}).Info("new edge")
+	}).Log(l.resultLogLevel(), "new edge")
+}
+
+// resultLogLevel returns the level at which new items and edges are logged
+func (l *requestHandler) resultLogLevel() log.Level {
+	if l.liveProgress {
+		return log.DebugLevel
+	}
+	return log.InfoLevel
 }
 
 func (l *requestHandler) Error(ctx context.Context, errorMessage string) {
@@ -89,17 +113,21 @@ func (l *requestHandler) QueryStatus(ctx context.Context, status *sdp.QueryStatu
 	}
 	statusFields["query"] = queryUuid
 
-	if status.GetStatus() == sdp.QueryStatus_STARTED {
-		l.queriesStarted += 1
-	}
-
+	l.mu.Lock()
 	// nolint:exhaustive // we _want_ to log all other status fields as unexpected
 	switch status.GetStatus() {
-	case sdp.QueryStatus_STARTED, sdp.QueryStatus_FINISHED, sdp.QueryStatus_ERRORED, sdp.QueryStatus_CANCELLED:
-		// do nothing
+	case sdp.QueryStatus_STARTED:
+		l.queriesStarted += 1
+	case sdp.QueryStatus_FINISHED:
+		l.queriesFinished += 1
+	case sdp.QueryStatus_ERRORED:
+		l.queriesErrored += 1
+	case sdp.QueryStatus_CANCELLED:
+		l.queriesCancelled += 1
 	default:
 		statusFields["unexpected_status"] = true
 	}
+	l.mu.Unlock()
 
 	log.WithContext(ctx).WithFields(l.lf).WithFields(statusFields).Debugf("query status update")
 }
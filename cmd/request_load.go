@@ -4,7 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/overmindtech/sdp-go"
 	"github.com/overmindtech/sdp-go/sdpws"
@@ -12,12 +17,20 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // requestLoadCmd represents the start command
 var requestLoadCmd = &cobra.Command{
-	Use:    "load",
-	Short:  "Loads a snapshot or bookmark from the overmind API",
+	Use:   "load",
+	Short: "Loads a snapshot or bookmark from the overmind API",
+	Long: `Loads a snapshot or bookmark from the overmind API.
+
+Bookmarks can contain template parameters in the form ${name} in the type,
+scope and query of their queries. When loading such a bookmark, supply a value
+for every parameter using --param name=value. The parameters are resolved
+locally and the resulting queries are run instead of loading the stored
+bookmark, excluded items are not applied in this case.`,
 	PreRun: PreRunSetup,
 	RunE:   RequestLoad,
 }
@@ -44,6 +57,14 @@ func RequestLoad(cmd *cobra.Command, args []string) error {
 		return flagError{fmt.Sprintf("Failed to parse UUID '%v': %v\n\n%v", uuidString, err, cmd.UsageString())}
 	}
 
+	params, err := parseKeyValueFlag("param")
+	if err != nil {
+		return flagError{fmt.Sprintf("%v\n\n%v", err, cmd.UsageString())}
+	}
+	if len(params) > 0 && !isBookmark {
+		return flagError{fmt.Sprintf("--param can only be used with --bookmark-uuid\n\n%v", cmd.UsageString())}
+	}
+
 	ctx, oi, _, err := login(ctx, cmd, []string{"explore:read", "changes:read"}, nil)
 	if err != nil {
 		return err
@@ -78,7 +99,55 @@ func RequestLoad(cmd *cobra.Command, args []string) error {
 	defer c.Close(ctx)
 
 	// Send the load request
-	if isBookmark {
+	if isBookmark && len(params) > 0 {
+		response, err := AuthenticatedBookmarkClient(ctx, oi).GetBookmark(ctx, &connect.Request[sdp.GetBookmarkRequest]{
+			Msg: &sdp.GetBookmarkRequest{
+				UUID: u[:],
+			},
+		})
+		if err != nil {
+			return loggedError{
+				err:     err,
+				fields:  lf,
+				message: "Failed to get bookmark",
+			}
+		}
+
+		properties := response.Msg.GetBookmark().GetProperties()
+		err = resolveBookmarkTemplate(properties, params)
+		if err != nil {
+			return flagError{fmt.Sprintf("%v\n\n%v", err, cmd.UsageString())}
+		}
+
+		// run the resolved queries directly, the stored bookmark still
+		// contains the unresolved templates
+		uuids := uuid.UUIDs{}
+		for _, q := range properties.GetQueries() {
+			qu := uuid.New()
+			q.UUID = qu[:]
+			q.Deadline = timestamppb.New(time.Now().Add(10 * time.Hour))
+			err = c.SendQuery(ctx, q)
+			if err != nil {
+				return loggedError{
+					err:     err,
+					fields:  lf,
+					message: "Failed to execute query",
+				}
+			}
+			uuids = append(uuids, qu)
+		}
+
+		err = c.Wait(ctx, uuids)
+		if err != nil {
+			log.WithContext(ctx).WithFields(lf).WithError(err).Error("queries failed")
+		}
+
+		log.WithContext(ctx).WithFields(lf).WithFields(log.Fields{
+			"queriesStarted": len(uuids),
+			"itemsReceived":  len(handler.items),
+			"edgesReceived":  len(handler.edges),
+		}).Info("bookmark loaded")
+	} else if isBookmark {
 		err = c.SendLoadBookmark(ctx, &sdp.LoadBookmark{
 			UUID: u[:],
 		})
@@ -170,6 +239,68 @@ func RequestLoad(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// bookmarkParamName matches valid template parameter names
+var bookmarkParamName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// resolveBookmarkTemplate replaces all ${name} template parameters in the
+// bookmark's queries with the supplied values. Returns an error for malformed
+// parameters, or listing all parameters that were referenced but not
+// supplied.
+func resolveBookmarkTemplate(properties *sdp.BookmarkProperties, params map[string]string) error {
+	missing := map[string]bool{}
+	for _, q := range properties.GetQueries() {
+		for _, field := range []*string{&q.Type, &q.Scope, &q.Query} {
+			expanded, err := expandBookmarkParams(*field, params, missing)
+			if err != nil {
+				return err
+			}
+			*field = expanded
+		}
+	}
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("missing values for bookmark parameters: %v", strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// expandBookmarkParams replaces the ${name} parameters in s with their values.
+// Names without a value are recorded in missing. Any other use of `$` is
+// left untouched.
+func expandBookmarkParams(s string, params map[string]string, missing map[string]bool) (string, error) {
+	var result strings.Builder
+	rest := s
+	for {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			result.WriteString(rest)
+			return result.String(), nil
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated bookmark parameter in %q", s)
+		}
+		name := rest[start+2 : start+end]
+		if !bookmarkParamName.MatchString(name) {
+			return "", fmt.Errorf("invalid bookmark parameter name %q in %q", name, s)
+		}
+
+		result.WriteString(rest[:start])
+		value, ok := params[name]
+		if !ok {
+			missing[name] = true
+		}
+		result.WriteString(value)
+		rest = rest[start+end+1:]
+	}
+}
+
 func init() {
 	requestCmd.AddCommand(requestLoadCmd)
 
@@ -179,4 +310,5 @@ func init() {
 
 	requestLoadCmd.PersistentFlags().String("bookmark-uuid", "", "The UUID of the bookmark or snapshot to load")
 	requestLoadCmd.PersistentFlags().String("snapshot-uuid", "", "The UUID of the snapshot to load")
+	requestLoadCmd.PersistentFlags().StringSlice("param", []string{}, "Values for the template parameters used in the bookmark's queries, these should be specified in key=value format. Multiple parameters can be specified by repeating the flag or using a comma separated list.")
 }
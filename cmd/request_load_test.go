@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+func templatedBookmark() *sdp.BookmarkProperties {
+	return &sdp.BookmarkProperties{
+		Name: "team resources",
+		Queries: []*sdp.Query{
+			{
+				Type:   "ec2-instance",
+				Method: sdp.QueryMethod_SEARCH,
+				Scope:  "${account}.${region}",
+				Query:  "tag:owner=${team}",
+			},
+			{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_LIST,
+				Scope:  "${account}",
+			},
+		},
+	}
+}
+
+func TestResolveBookmarkTemplate(t *testing.T) {
+	t.Run("with all parameters", func(t *testing.T) {
+		b := templatedBookmark()
+		err := resolveBookmarkTemplate(b, map[string]string{
+			"account": "123456789012",
+			"region":  "eu-west-2",
+			"team":    "platform",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if b.GetQueries()[0].GetScope() != "123456789012.eu-west-2" {
+			t.Errorf("unexpected scope: %v", b.GetQueries()[0].GetScope())
+		}
+		if b.GetQueries()[0].GetQuery() != "tag:owner=platform" {
+			t.Errorf("unexpected query: %v", b.GetQueries()[0].GetQuery())
+		}
+		if b.GetQueries()[1].GetScope() != "123456789012" {
+			t.Errorf("unexpected scope: %v", b.GetQueries()[1].GetScope())
+		}
+		if b.GetQueries()[1].GetType() != "s3-bucket" {
+			t.Errorf("unexpected type: %v", b.GetQueries()[1].GetType())
+		}
+	})
+
+	t.Run("other uses of $ are untouched", func(t *testing.T) {
+		b := &sdp.BookmarkProperties{
+			Queries: []*sdp.Query{
+				{Type: "a$b", Scope: "price$$", Query: "tag:x=$1"},
+			},
+		}
+		err := resolveBookmarkTemplate(b, map[string]string{"team": "platform"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		q := b.GetQueries()[0]
+		if q.GetType() != "a$b" || q.GetScope() != "price$$" || q.GetQuery() != "tag:x=$1" {
+			t.Errorf("query was modified: %v", q)
+		}
+	})
+
+	t.Run("with malformed parameters", func(t *testing.T) {
+		for _, query := range []string{"${}", "${team", "${bad name}"} {
+			b := &sdp.BookmarkProperties{
+				Queries: []*sdp.Query{
+					{Type: "dns", Scope: "global", Query: query},
+				},
+			}
+			err := resolveBookmarkTemplate(b, map[string]string{"team": "platform"})
+			if err == nil {
+				t.Errorf("expected error for %q", query)
+			}
+		}
+	})
+
+	t.Run("with missing parameters", func(t *testing.T) {
+		b := templatedBookmark()
+		err := resolveBookmarkTemplate(b, map[string]string{
+			"account": "123456789012",
+		})
+		if err == nil {
+			t.Fatal("expected error for unresolved parameters")
+		}
+		expected := "missing values for bookmark parameters: region, team"
+		if err.Error() != expected {
+			t.Errorf("expected %q, got %q", expected, err.Error())
+		}
+	})
+}
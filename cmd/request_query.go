@@ -41,6 +41,7 @@ func RequestQuery(cmd *cobra.Command, args []string) error {
 		msgLog:                       []*sdp.GatewayResponse{},
 		bookmarkLoadResult:           make(chan *sdp.BookmarkLoadResult, 128),
 		snapshotLoadResult:           make(chan *sdp.SnapshotLoadResult, 128),
+		liveProgress:                 viper.GetBool("live-progress"),
 	}
 	gatewayUrl := oi.GatewayUrl()
 	lf["gateway-url"] = gatewayUrl
@@ -83,7 +84,17 @@ func RequestQuery(cmd *cobra.Command, args []string) error {
 	}
 	log.WithContext(ctx).WithFields(lf).WithField("uuid", uuid.UUID(q.GetUUID())).Infof("Query:\n%v", string(b))
 
+	var stopProgress func()
+	if handler.liveProgress {
+		stopProgress, err = handler.displayProgress(ctx)
+		if err != nil {
+			log.WithContext(ctx).WithFields(lf).WithError(err).Warn("could not show live progress")
+		}
+	}
 	err = c.Wait(ctx, uuid.UUIDs{uuid.UUID(q.GetUUID())})
+	if stopProgress != nil {
+		stopProgress()
+	}
 	if err != nil {
 		log.WithContext(ctx).WithFields(lf).WithError(err).Error("queries failed")
 	}
@@ -184,6 +195,7 @@ func init() {
 	addAPIFlags(requestQueryCmd)
 
 	requestQueryCmd.PersistentFlags().String("dump-json", "", "Dump the request to the given file as JSON")
+	requestQueryCmd.PersistentFlags().Bool("live-progress", false, "Show a live updating view of the query progress while waiting for results. New items and edges are only logged at debug level while the view is shown.")
 
 	requestQueryCmd.PersistentFlags().String("query-method", "get", "The method to use (get, list, search)")
 	requestQueryCmd.PersistentFlags().String("query-type", "*", "The type to query")
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/overmindtech/pterm"
+)
+
+// progressString renders the current progress of all queries handled by this
+// handler
+func (l *requestHandler) progressString() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	working := l.queriesStarted - l.queriesFinished - l.queriesErrored - l.queriesCancelled
+	if working < 0 {
+		// a terminal status can arrive for a query we did not see start
+		working = 0
+	}
+
+	return fmt.Sprintf("Queries: %v working, %v complete, %v errored, %v cancelled\nReceived %v items and %v edges",
+		working, l.queriesFinished, l.queriesErrored, l.queriesCancelled, len(l.items), len(l.edges))
+}
+
+// displayProgress renders the handler's progress into a live updating area on
+// the terminal until the returned function is called. The returned function
+// renders the final state and releases the terminal.
+func (l *requestHandler) displayProgress(ctx context.Context) (func(), error) {
+	area, err := pterm.DefaultArea.Start(l.progressString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to start progress display: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				area.Update(l.progressString())
+				return
+			case <-ticker.C:
+				area.Update(l.progressString())
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		_ = area.Stop()
+	}, nil
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/overmindtech/sdp-go"
+	"github.com/overmindtech/sdp-go/sdpws"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestRequestHandlerProgress(t *testing.T) {
+	ctx := context.Background()
+	handler := &requestHandler{
+		lf:                           log.Fields{},
+		LoggingGatewayMessageHandler: sdpws.LoggingGatewayMessageHandler{Level: log.TraceLevel},
+	}
+
+	status := func(s sdp.QueryStatus_Status) {
+		u := uuid.New()
+		handler.QueryStatus(ctx, &sdp.QueryStatus{
+			UUID:   u[:],
+			Status: s,
+		})
+	}
+
+	attrs, err := sdp.ToAttributes(map[string]interface{}{"name": "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	item := &sdp.Item{
+		Type:            "dns",
+		UniqueAttribute: "name",
+		Scope:           "global",
+		Attributes:      attrs,
+	}
+	edge := &sdp.Edge{
+		From: item.Reference(),
+		To:   item.Reference(),
+	}
+
+	status(sdp.QueryStatus_STARTED)
+	status(sdp.QueryStatus_STARTED)
+	status(sdp.QueryStatus_STARTED)
+	expected := "Queries: 3 working, 0 complete, 0 errored, 0 cancelled\nReceived 0 items and 0 edges"
+	if got := handler.progressString(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	handler.NewItem(ctx, item)
+	handler.NewItem(ctx, item)
+	handler.NewEdge(ctx, edge)
+	status(sdp.QueryStatus_FINISHED)
+	status(sdp.QueryStatus_ERRORED)
+	expected = "Queries: 1 working, 1 complete, 1 errored, 0 cancelled\nReceived 2 items and 1 edges"
+	if got := handler.progressString(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	status(sdp.QueryStatus_CANCELLED)
+	// a stray terminal status must not result in negative working queries
+	status(sdp.QueryStatus_FINISHED)
+	expected = "Queries: 0 working, 2 complete, 1 errored, 1 cancelled\nReceived 2 items and 1 edges"
+	if got := handler.progressString(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestRequestHandlerResultLogLevel(t *testing.T) {
+	handler := &requestHandler{}
+	if got := handler.resultLogLevel(); got != log.InfoLevel {
+		t.Errorf("expected %v without live progress, got %v", log.InfoLevel, got)
+	}
+
+	handler.liveProgress = true
+	if got := handler.resultLogLevel(); got != log.DebugLevel {
+		t.Errorf("expected %v with live progress, got %v", log.DebugLevel, got)
+	}
+}
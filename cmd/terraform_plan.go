@@ -233,7 +233,7 @@ func TerraformPlanImpl(ctx context.Context, cmd *cobra.Command, oi sdp.OvermindI
 	if repoUrl == "" {
 		repoUrl, _ = DetectRepoURL(AllDetectors)
 	}
-	tags, err := parseTagsArgument()
+	tags, err := parseKeyValueFlag("tags")
 	if err != nil {
 		uploadChangesSpinner.Fail(fmt.Sprintf("Uploading planned changes: failed to parse tags: %v", err))
 		return nil
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
@@ -81,7 +82,18 @@ func InitTracerWithHoneycomb(key string, opts ...otlptracehttp.Option) error {
 	return InitTracer(opts...)
 }
 
+// InitTracer sets up tracing using an OTLP exporter over HTTP
 func InitTracer(opts ...otlptracehttp.Option) error {
+	return initTracerWithClient(otlptracehttp.NewClient(opts...))
+}
+
+// InitTracerGRPC sets up tracing using an OTLP exporter over gRPC, for
+// collectors that do not accept OTLP over HTTP
+func InitTracerGRPC(opts ...otlptracegrpc.Option) error {
+	return initTracerWithClient(otlptracegrpc.NewClient(opts...))
+}
+
+func initTracerWithClient(client otlptrace.Client) error {
 	if sentry_dsn := viper.GetString("sentry-dsn"); sentry_dsn != "" {
 		var environment string
 		if viper.GetString("run-mode") == "release" {
@@ -108,7 +120,6 @@ func InitTracer(opts ...otlptracehttp.Option) error {
 		log.Debug("sentry configured")
 	}
 
-	client := otlptracehttp.NewClient(opts...)
 	otlpExp, err := otlptrace.New(context.Background(), client)
 	if err != nil {
 		return fmt.Errorf("creating OTLP trace exporter: %w", err)
@@ -153,25 +164,41 @@ func ShutdownTracer() {
 }
 
 type UserAgentSampler struct {
-	userAgent           string
+	samplers map[string]userAgentRateSampler
+}
+
+type userAgentRateSampler struct {
 	innerSampler        sdktrace.Sampler
 	sampleRateAttribute attribute.KeyValue
 }
 
+// NewUserAgentSampler returns a sampler that samples requests from the given
+// user agent at 1/sampleRate, and all other requests always.
 func NewUserAgentSampler(userAgent string, sampleRate int) *UserAgentSampler {
-	var innerSampler sdktrace.Sampler
-	switch {
-	case sampleRate <= 0:
-		innerSampler = sdktrace.NeverSample()
-	case sampleRate == 1:
-		innerSampler = sdktrace.AlwaysSample()
-	default:
-		innerSampler = sdktrace.TraceIDRatioBased(1.0 / float64(sampleRate))
+	return NewUserAgentSamplerMap(map[string]int{userAgent: sampleRate})
+}
+
+// NewUserAgentSamplerMap returns a sampler that samples requests from each
+// user agent in the map at 1/rate, and all other requests always.
+func NewUserAgentSamplerMap(sampleRates map[string]int) *UserAgentSampler {
+	samplers := make(map[string]userAgentRateSampler, len(sampleRates))
+	for userAgent, sampleRate := range sampleRates {
+		var innerSampler sdktrace.Sampler
+		switch {
+		case sampleRate <= 0:
+			innerSampler = sdktrace.NeverSample()
+		case sampleRate == 1:
+			innerSampler = sdktrace.AlwaysSample()
+		default:
+			innerSampler = sdktrace.TraceIDRatioBased(1.0 / float64(sampleRate))
+		}
+		samplers[userAgent] = userAgentRateSampler{
+			innerSampler:        innerSampler,
+			sampleRateAttribute: attribute.Int("SampleRate", sampleRate),
+		}
 	}
 	return &UserAgentSampler{
-		userAgent:           userAgent,
-		innerSampler:        innerSampler,
-		sampleRateAttribute: attribute.Int("SampleRate", sampleRate),
+		samplers: samplers,
 	}
 }
 
@@ -179,10 +206,13 @@ func NewUserAgentSampler(userAgent string, sampleRate int) *UserAgentSampler {
 // passed parameters.
 func (h *UserAgentSampler) ShouldSample(parameters sdktrace.SamplingParameters) sdktrace.SamplingResult {
 	for _, attr := range parameters.Attributes {
-		if attr.Key == "http.user_agent" && attr.Value.AsString() == h.userAgent {
-			result := h.innerSampler.ShouldSample(parameters)
+		if attr.Key != "http.user_agent" {
+			continue
+		}
+		if sampler, ok := h.samplers[attr.Value.AsString()]; ok {
+			result := sampler.innerSampler.ShouldSample(parameters)
 			if result.Decision == sdktrace.RecordAndSample {
-				result.Attributes = append(result.Attributes, h.sampleRateAttribute)
+				result.Attributes = append(result.Attributes, sampler.sampleRateAttribute)
 			}
 			return result
 		}
@@ -1,7 +1,22 @@
 package tracing
 
 import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestTracingResource(t *testing.T) {
@@ -10,3 +25,131 @@ func TestTracingResource(t *testing.T) {
 		t.Error("Could not initialize tracing resource. Check the log!")
 	}
 }
+
+// testTraceCollector counts the spans it receives via OTLP
+type testTraceCollector struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	spans atomic.Int64
+}
+
+func (c *testTraceCollector) Export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	for _, rs := range req.GetResourceSpans() {
+		for _, ss := range rs.GetScopeSpans() {
+			c.spans.Add(int64(len(ss.GetSpans())))
+		}
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func TestInitTracerHTTP(t *testing.T) {
+	collector := &testTraceCollector{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request: %v", err)
+		}
+		req := &coltracepb.ExportTraceServiceRequest{}
+		err = proto.Unmarshal(body, req)
+		if err != nil {
+			t.Errorf("failed to unmarshal request: %v", err)
+		}
+		_, _ = collector.Export(r.Context(), req)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	err := InitTracer(otlptracehttp.WithEndpointURL(srv.URL))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, span := tp.Tracer("test").Start(context.Background(), "test")
+	span.End()
+	ShutdownTracer()
+
+	if got := collector.spans.Load(); got != 1 {
+		t.Errorf("expected 1 exported span, got %v", got)
+	}
+}
+
+func TestInitTracerGRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	collector := &testTraceCollector{}
+	srv := grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(srv, collector)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+
+	err = InitTracerGRPC(
+		otlptracegrpc.WithEndpoint(lis.Addr().String()),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, span := tp.Tracer("test").Start(context.Background(), "test")
+	span.End()
+	ShutdownTracer()
+
+	if got := collector.spans.Load(); got != 1 {
+		t.Errorf("expected 1 exported span, got %v", got)
+	}
+}
+
+func TestUserAgentSamplerMap(t *testing.T) {
+	sampler := NewUserAgentSamplerMap(map[string]int{
+		"ELB-HealthChecker/2.0": 200,
+		"kube-probe/1.30":       1000,
+		"debug-agent":           1,
+	})
+
+	// TraceIDRatioBased samples based on the lower half of the trace ID, so
+	// all zeros is always sampled and all ones never is
+	lowTraceID := trace.TraceID{}
+	highTraceID := trace.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	tests := []struct {
+		name         string
+		userAgent    string
+		traceID      trace.TraceID
+		wantDecision sdktrace.SamplingDecision
+		wantRate     int
+	}{
+		{name: "ELB sampled", userAgent: "ELB-HealthChecker/2.0", traceID: lowTraceID, wantDecision: sdktrace.RecordAndSample, wantRate: 200},
+		{name: "ELB dropped", userAgent: "ELB-HealthChecker/2.0", traceID: highTraceID, wantDecision: sdktrace.Drop},
+		{name: "kube-probe sampled", userAgent: "kube-probe/1.30", traceID: lowTraceID, wantDecision: sdktrace.RecordAndSample, wantRate: 1000},
+		{name: "kube-probe dropped", userAgent: "kube-probe/1.30", traceID: highTraceID, wantDecision: sdktrace.Drop},
+		{name: "rate of one", userAgent: "debug-agent", traceID: highTraceID, wantDecision: sdktrace.RecordAndSample, wantRate: 1},
+		{name: "non-matching", userAgent: "curl/8.0", traceID: highTraceID, wantDecision: sdktrace.RecordAndSample},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sampler.ShouldSample(sdktrace.SamplingParameters{
+				TraceID:    tt.traceID,
+				Attributes: []attribute.KeyValue{attribute.String("http.user_agent", tt.userAgent)},
+			})
+			if result.Decision != tt.wantDecision {
+				t.Fatalf("expected decision %v, got %v", tt.wantDecision, result.Decision)
+			}
+
+			var gotRate int
+			for _, attr := range result.Attributes {
+				if attr.Key == "SampleRate" {
+					gotRate = int(attr.Value.AsInt64())
+				}
+			}
+			if gotRate != tt.wantRate {
+				t.Errorf("expected SampleRate %v, got %v", tt.wantRate, gotRate)
+			}
+		})
+	}
+}